@@ -7,7 +7,6 @@ package benfords
 import (
 	"log"
 	"math"
-	"strconv"
 
 	"golang.org/x/exp/rand"
 	"gonum.org/v1/gonum/stat"
@@ -177,21 +176,6 @@ func ComputeLeadDigitDistribution(values []float64, base int) ([]float64, int) {
 	return dist, int(validValues)
 }
 
-// ComputeLeadDigitDistributionFromStrings does what it says
-// relying on the strconv.ParseFloat function
-func ComputeLeadDigitDistributionFromStrings(strings []string, base int) ([]float64, int) {
-	numericValues := make([]float64, len(strings))
-	count := 0
-	for _, v := range strings {
-		asFloat, err := strconv.ParseFloat(v, 64)
-		if err == nil && asFloat != 0.0 && !math.IsNaN(asFloat) {
-			numericValues[count] = asFloat
-			count++
-		}
-	}
-	return ComputeLeadDigitDistribution(numericValues[0:count], base)
-}
-
 // ChiSquarePValue for the given distribution
 func (b Benfords) ChiSquarePValue(dist []float64) float64 {
 	if len(dist) != (b.Base - 1) {
@@ -0,0 +1,145 @@
+package benfords
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"math"
+
+	"golang.org/x/exp/rand"
+	"gonum.org/v1/gonum/stat"
+)
+
+// defaultPermutations is the number of permutations used by
+// CompareDistributions when estimating the permutation p-value.
+const defaultPermutations = 2000
+
+// Report is the result of comparing the first-digit distributions of two
+// samples, as produced by CompareDistributions.
+type Report struct {
+	Base int
+
+	NA int
+	NB int
+
+	DistA []float64
+	DistB []float64
+
+	// Deltas is DistB[i] - DistA[i] for each digit i.
+	Deltas []float64
+
+	// L1Distance is the L1 (total variation x2) distance between DistA and DistB.
+	L1Distance float64
+
+	// ChiSquare compares each sample's empirical distribution against the
+	// pooled distribution of both samples combined.
+	ChiSquare float64
+
+	// PermutationPValue is a permutation-test p-value for the null
+	// hypothesis that a and b are drawn from the same digit distribution,
+	// using L1Distance as the test statistic.
+	PermutationPValue float64
+}
+
+// CompareDistributions ingests two sets of numeric samples and compares
+// their first-digit distributions in the given base, akin to comparing two
+// benchstat inputs. It reports per-digit frequencies and deltas, a
+// chi-square statistic against the pooled distribution, and a
+// permutation-based p-value on the L1 distance between the two empirical
+// distributions.
+func CompareDistributions(a, b []float64, base int) Report {
+	return compareDistributions(a, b, base, defaultPermutations, rand.NewSource(1))
+}
+
+func compareDistributions(a, b []float64, base int, permutations int, src rand.Source) Report {
+	distA, nA := ComputeLeadDigitDistribution(a, base)
+	distB, nB := ComputeLeadDigitDistribution(b, base)
+	if nA == 0 || nB == 0 {
+		log.Panic("benfords: CompareDistributions needs at least one valid value in each sample")
+	}
+
+	deltas := make([]float64, len(distA))
+	l1 := 0.0
+	for i := range deltas {
+		deltas[i] = distB[i] - distA[i]
+		l1 += math.Abs(deltas[i])
+	}
+
+	pooledDist := make([]float64, len(distA))
+	total := float64(nA + nB)
+	for i := range pooledDist {
+		pooledDist[i] = (distA[i]*float64(nA) + distB[i]*float64(nB)) / total
+	}
+	// pooledDist is the denominator (expected) side, as it's the only one
+	// guaranteed nonzero anywhere distA or distB is nonzero.
+	chiSq := stat.ChiSquare(distA, pooledDist) + stat.ChiSquare(distB, pooledDist)
+
+	return Report{
+		Base:              base,
+		NA:                nA,
+		NB:                nB,
+		DistA:             distA,
+		DistB:             distB,
+		Deltas:            deltas,
+		L1Distance:        l1,
+		ChiSquare:         chiSq,
+		PermutationPValue: permutationPValue(a, b, base, l1, permutations, src),
+	}
+}
+
+// permutationPValue estimates the p-value of observedL1 under the null
+// hypothesis that a and b come from the same distribution, by repeatedly
+// shuffling the pooled sample between the two groups.
+func permutationPValue(a, b []float64, base int, observedL1 float64, permutations int, src rand.Source) float64 {
+	rnd := rand.New(src)
+	pooled := make([]float64, 0, len(a)+len(b))
+	pooled = append(pooled, a...)
+	pooled = append(pooled, b...)
+	nA := len(a)
+
+	atLeastAsExtreme := 0
+	perm := make([]float64, len(pooled))
+	for p := 0; p < permutations; p++ {
+		copy(perm, pooled)
+		rnd.Shuffle(len(perm), func(i, j int) { perm[i], perm[j] = perm[j], perm[i] })
+		distA, _ := ComputeLeadDigitDistribution(perm[:nA], base)
+		distB, _ := ComputeLeadDigitDistribution(perm[nA:], base)
+		l1 := 0.0
+		for i := range distA {
+			l1 += math.Abs(distA[i] - distB[i])
+		}
+		if l1 >= observedL1 {
+			atLeastAsExtreme++
+		}
+	}
+	return float64(atLeastAsExtreme+1) / float64(permutations+1)
+}
+
+// WriteText renders the report as a plain-text, side-by-side table of
+// first-digit frequencies and deltas.
+func (r Report) WriteText(w io.Writer) error {
+	fmt.Fprintf(w, "digit\tA (n=%d)\tB (n=%d)\tdelta\n", r.NA, r.NB)
+	for i := range r.DistA {
+		fmt.Fprintf(w, "%d\t%.4f\t%.4f\t%+.4f\n", i+1, r.DistA[i], r.DistB[i], r.Deltas[i])
+	}
+	fmt.Fprintf(w, "\nchi-square (vs pooled): %.4f\n", r.ChiSquare)
+	fmt.Fprintf(w, "L1 distance: %.4f\n", r.L1Distance)
+	fmt.Fprintf(w, "permutation p-value: %.4f\n", r.PermutationPValue)
+	return nil
+}
+
+// WriteHTML renders the report as a minimal standalone HTML table.
+func (r Report) WriteHTML(w io.Writer) error {
+	fmt.Fprintln(w, "<table border=\"1\">")
+	fmt.Fprintf(w, "<tr><th>digit</th><th>A (n=%d)</th><th>B (n=%d)</th><th>delta</th></tr>\n", r.NA, r.NB)
+	for i := range r.DistA {
+		fmt.Fprintf(w, "<tr><td>%d</td><td>%.4f</td><td>%.4f</td><td>%+.4f</td></tr>\n", i+1, r.DistA[i], r.DistB[i], r.Deltas[i])
+	}
+	fmt.Fprintln(w, "</table>")
+	fmt.Fprintf(w, "<p>chi-square (vs pooled): %.4f</p>\n", r.ChiSquare)
+	fmt.Fprintf(w, "<p>L1 distance: %.4f</p>\n", r.L1Distance)
+	fmt.Fprintf(w, "<p>permutation p-value: %.4f</p>\n", r.PermutationPValue)
+	return nil
+}
+
+// eof
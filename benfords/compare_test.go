@@ -0,0 +1,72 @@
+package benfords_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/DataFinnovation/go-benfords/benfords"
+)
+
+func TestCompareDistributionsIdentical(t *testing.T) {
+	b := benfords.Benfords{Base: 10}
+	values := make([]float64, 5000)
+	for i := range values {
+		values[i] = float64(b.Rand())
+	}
+
+	report := benfords.CompareDistributions(values, values, 10)
+
+	for i, d := range report.Deltas {
+		if math.Abs(d) > 1e-9 {
+			t.Errorf("expected zero delta for identical samples at digit %d, got %v", i+1, d)
+		}
+	}
+	if report.PermutationPValue < 0.5 {
+		t.Errorf("expected a high permutation p-value for identical samples, got %v", report.PermutationPValue)
+	}
+}
+
+func TestCompareDistributionsDrift(t *testing.T) {
+	benford := benfords.Benfords{Base: 10}
+	a := make([]float64, 5000)
+	for i := range a {
+		a[i] = float64(benford.Rand())
+	}
+	// b is skewed heavily toward digit 9, a clear drift away from Benford.
+	b := make([]float64, 5000)
+	for i := range b {
+		b[i] = 9
+	}
+
+	report := benfords.CompareDistributions(a, b, 10)
+	if report.PermutationPValue > 0.05 {
+		t.Errorf("expected a low permutation p-value for clearly drifted samples, got %v", report.PermutationPValue)
+	}
+}
+
+func TestCompareDistributionsChiSquareFiniteWithMissingDigit(t *testing.T) {
+	// b never observes digit 9 at all, a small-sample situation that's
+	// common in practice; the pooled distribution should still be
+	// nonzero everywhere, so the chi-square statistic must stay finite.
+	a := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	b := []float64{1, 2, 3, 4, 5}
+
+	report := benfords.CompareDistributions(a, b, 10)
+	if math.IsInf(report.ChiSquare, 0) || math.IsNaN(report.ChiSquare) {
+		t.Errorf("expected a finite chi-square, got %v", report.ChiSquare)
+	}
+	if math.IsNaN(report.L1Distance) {
+		t.Errorf("expected a finite L1 distance, got %v", report.L1Distance)
+	}
+}
+
+func TestCompareDistributionsPanicsOnEmptyInput(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected CompareDistributions to panic on an empty sample")
+		}
+	}()
+	benfords.CompareDistributions(nil, []float64{1, 2, 3}, 10)
+}
+
+// eof
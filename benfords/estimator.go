@@ -0,0 +1,121 @@
+package benfords
+
+import (
+	"math"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// estimatorShard holds one shard's worth of running first-digit counts,
+// guarded by its own mutex so that goroutines feeding different shards
+// never contend with each other.
+type estimatorShard struct {
+	mu     sync.Mutex
+	counts []float64
+	total  int
+}
+
+// OnlineEstimator consumes values in a streaming fashion and maintains a
+// running first-digit distribution, usable on datasets too large to hold
+// in memory at once. It shards its counters so that it can be fed safely
+// from multiple goroutines; call Snapshot to merge the shards and compute
+// the current goodness-of-fit statistics.
+type OnlineEstimator struct {
+	Base int
+
+	shards []*estimatorShard
+	next   uint64
+}
+
+// NewOnlineEstimator returns an OnlineEstimator for the given base, sharded
+// across GOMAXPROCS counters.
+func NewOnlineEstimator(base int) *OnlineEstimator {
+	shardCount := runtime.GOMAXPROCS(0)
+	if shardCount < 1 {
+		shardCount = 1
+	}
+	shards := make([]*estimatorShard, shardCount)
+	for i := range shards {
+		shards[i] = &estimatorShard{counts: make([]float64, base-1)}
+	}
+	return &OnlineEstimator{Base: base, shards: shards}
+}
+
+// shard picks a shard to update, round-robin, via an atomic counter so
+// concurrent callers don't contend on a shared lock just to pick one.
+func (e *OnlineEstimator) shard() *estimatorShard {
+	idx := atomic.AddUint64(&e.next, 1) % uint64(len(e.shards))
+	return e.shards[idx]
+}
+
+// Add records a single value, ignoring zeros and NaNs. It is safe to call
+// concurrently from multiple goroutines.
+func (e *OnlineEstimator) Add(v float64) {
+	if v == 0.0 || math.IsNaN(v) {
+		return
+	}
+	leadDigit := LeadDigit(v, e.Base)
+	s := e.shard()
+	s.mu.Lock()
+	s.counts[leadDigit-1]++
+	s.total++
+	s.mu.Unlock()
+}
+
+// AddBatch records a slice of values. It is safe to call concurrently from
+// multiple goroutines, including alongside Add.
+func (e *OnlineEstimator) AddBatch(values []float64) {
+	for _, v := range values {
+		e.Add(v)
+	}
+}
+
+// EstimatorSnapshot is a point-in-time summary of an OnlineEstimator's
+// running state.
+type EstimatorSnapshot struct {
+	Base int
+	N    int
+	Dist []float64
+
+	ChiSquare float64
+	ChoGaines float64
+	Leemis    float64
+	Kuiper    float64
+}
+
+// Snapshot merges the sharded counters into a single empirical distribution
+// and computes the chi-square, Cho-Gaines, Leemis and Kuiper statistics
+// against the ideal Benford PDF for the estimator's base.
+func (e *OnlineEstimator) Snapshot() EstimatorSnapshot {
+	counts := make([]float64, e.Base-1)
+	total := 0
+	for _, s := range e.shards {
+		s.mu.Lock()
+		for i, c := range s.counts {
+			counts[i] += c
+		}
+		total += s.total
+		s.mu.Unlock()
+	}
+
+	dist := make([]float64, len(counts))
+	if total > 0 {
+		for i, c := range counts {
+			dist[i] = c / float64(total)
+		}
+	}
+
+	b := Benfords{Base: e.Base}
+	return EstimatorSnapshot{
+		Base:      e.Base,
+		N:         total,
+		Dist:      dist,
+		ChiSquare: b.ChiSquarePValue(dist),
+		ChoGaines: b.ChoGainesStat(total, dist),
+		Leemis:    b.LeemisStat(total, dist),
+		Kuiper:    b.KuiperStat(total, dist),
+	}
+}
+
+// eof
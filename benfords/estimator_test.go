@@ -0,0 +1,44 @@
+package benfords_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/DataFinnovation/go-benfords/benfords"
+)
+
+func TestOnlineEstimatorConcurrent(t *testing.T) {
+	const (
+		workers       = 8
+		perWorker     = 50000
+		expectedTotal = workers * perWorker
+	)
+
+	est := benfords.NewOnlineEstimator(10)
+	b := benfords.Benfords{Base: 10}
+	sampler := benfords.NewSampler(b)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			values := make([]float64, perWorker)
+			for i, d := range sampler.RandBatch(perWorker) {
+				values[i] = float64(d)
+			}
+			est.AddBatch(values)
+		}()
+	}
+	wg.Wait()
+
+	snap := est.Snapshot()
+	if snap.N != expectedTotal {
+		t.Fatalf("expected %d total observations, got %d", expectedTotal, snap.N)
+	}
+	if snap.ChiSquare > 0.01 {
+		t.Errorf("chi-square stat looks invalid for Benford-distributed stream: %v", snap.ChiSquare)
+	}
+}
+
+// eof
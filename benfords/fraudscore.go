@@ -0,0 +1,139 @@
+package benfords
+
+import (
+	"log"
+	"math"
+)
+
+// ConformityLevel describes how closely a realised first-digit
+// distribution conforms to Benford's law, using Nigrini's published MAD
+// thresholds for base 10 first-digit tests.
+type ConformityLevel int
+
+const (
+	// Close indicates close conformity to Benford's law.
+	Close ConformityLevel = iota
+	// Acceptable indicates acceptable conformity.
+	Acceptable
+	// Marginal indicates marginally acceptable conformity.
+	Marginal
+	// Nonconforming indicates nonconformity with Benford's law.
+	Nonconforming
+)
+
+// String returns a human-readable name for the conformity level.
+func (c ConformityLevel) String() string {
+	switch c {
+	case Close:
+		return "Close conformity"
+	case Acceptable:
+		return "Acceptable conformity"
+	case Marginal:
+		return "Marginally acceptable conformity"
+	default:
+		return "Nonconforming"
+	}
+}
+
+// Nigrini's published MAD thresholds for the base 10 first-digit test.
+const (
+	madCloseThreshold      = 0.006
+	madAcceptableThreshold = 0.012
+	madMarginalThreshold   = 0.015
+)
+
+// MAD returns the mean absolute deviation between realisedDist and the
+// ideal Benford PDF, the basis of Nigrini's conformity test.
+func (b Benfords) MAD(realisedDist []float64) float64 {
+	idealPDF := b.FullPDF()
+	if len(idealPDF) != len(realisedDist) {
+		log.Panic("length mismatch")
+	}
+	tot := 0.0
+	for i, r := range realisedDist {
+		tot += math.Abs(r - idealPDF[i])
+	}
+	return tot / float64(len(idealPDF))
+}
+
+// Conformity classifies a MAD value computed from a base 10 first-digit
+// test into one of Nigrini's published conformity levels.
+func Conformity(mad float64) ConformityLevel {
+	switch {
+	case mad < madCloseThreshold:
+		return Close
+	case mad < madAcceptableThreshold:
+		return Acceptable
+	case mad < madMarginalThreshold:
+		return Marginal
+	default:
+		return Nonconforming
+	}
+}
+
+// SumOfSquares returns the sum of squared deviations between realisedDist
+// and the ideal Benford PDF, a statistic used in the accounting and
+// forensics literature alongside MAD and chi-square.
+func (b Benfords) SumOfSquares(realisedDist []float64) float64 {
+	idealPDF := b.FullPDF()
+	if len(idealPDF) != len(realisedDist) {
+		log.Panic("length mismatch")
+	}
+	tot := 0.0
+	for i, r := range realisedDist {
+		tot += math.Pow(r-idealPDF[i], 2.0)
+	}
+	return tot
+}
+
+// leadValue returns the leading significant figure of n in the given base,
+// as a continuous value in [1, base) rather than a truncated digit. For
+// example leadValue(1234, 10) is 1.234, not 1.
+func leadValue(n float64, base int) float64 {
+	if n < 0 {
+		n = -n
+	}
+	if n == 0 {
+		return 0
+	}
+	for n < 1 {
+		n *= float64(base)
+	}
+	for n >= float64(base) {
+		n /= float64(base)
+	}
+	return n
+}
+
+// DistortionFactor returns Nigrini's distortion factor, which measures
+// whether values sit high within their leading-digit bucket, i.e. are
+// inflated (positive DF), or low within it, i.e. are deflated (negative
+// DF), catching patterns like amounts padded just under an approval
+// threshold (positive) or round-number invoices such as 100, 200, ...
+// (negative, since each sits at the bottom of its bucket). AM is the actual arithmetic mean of
+// the leading significant figure of values (e.g. 1234 contributes 1.234);
+// EM is the corresponding expected mean under Benford's law, approximated
+// from the midpoint of each leading-digit bucket. DF = 100*(AM-EM)/EM.
+func (b Benfords) DistortionFactor(values []float64) float64 {
+	total := 0.0
+	n := 0
+	for _, v := range values {
+		if v == 0.0 || math.IsNaN(v) {
+			continue
+		}
+		total += leadValue(v, b.Base)
+		n++
+	}
+	if n == 0 {
+		log.Panic("benfords: DistortionFactor needs at least one valid value")
+	}
+	actualMean := total / float64(n)
+
+	expectedMean := 0.0
+	for d := 1; d < b.Base; d++ {
+		expectedMean += (float64(d) + 0.5) * b.Prob(d)
+	}
+	return 100.0 * (actualMean - expectedMean) / expectedMean
+}
+
+// eof
@@ -0,0 +1,82 @@
+package benfords_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/DataFinnovation/go-benfords/benfords"
+	"golang.org/x/exp/rand"
+)
+
+func TestMADAndConformity(t *testing.T) {
+	const samples = 200000
+	b := benfords.Benfords{Base: 10}
+	pdf := b.FullPDF()
+
+	realised := make([]float64, len(pdf))
+	for i := 0; i < samples; i++ {
+		d := b.Rand()
+		realised[d-1] += 1.0 / float64(samples)
+	}
+
+	mad := b.MAD(realised)
+	if level := benfords.Conformity(mad); level != benfords.Close && level != benfords.Acceptable {
+		t.Errorf("expected Benford-distributed data to conform closely, got MAD=%v level=%v", mad, level)
+	}
+
+	// a distribution entirely concentrated on digit 9 should be
+	// nonconforming regardless of sample size.
+	skewed := make([]float64, len(pdf))
+	skewed[8] = 1.0
+	if level := benfords.Conformity(b.MAD(skewed)); level != benfords.Nonconforming {
+		t.Errorf("expected a fully digit-9 distribution to be nonconforming, got %v", level)
+	}
+}
+
+func TestSumOfSquares(t *testing.T) {
+	b := benfords.Benfords{Base: 10}
+	pdf := b.FullPDF()
+
+	if ss := b.SumOfSquares(pdf); ss != 0 {
+		t.Errorf("expected zero sum of squares for the ideal PDF, got %v", ss)
+	}
+
+	skewed := make([]float64, len(pdf))
+	skewed[8] = 1.0
+	if ss := b.SumOfSquares(skewed); ss <= 0 {
+		t.Errorf("expected a positive sum of squares for a distribution skewed to digit 9, got %v", ss)
+	}
+}
+
+func TestDistortionFactor(t *testing.T) {
+	const samples = 200000
+	b := benfords.Benfords{Base: 10}
+	src := rand.New(rand.NewSource(1))
+
+	// genuinely Benford-conforming data: log10(value) mod 1 is exactly
+	// uniform by construction, so AM should track EM closely.
+	conforming := make([]float64, samples)
+	for i := range conforming {
+		exp := float64(src.Intn(6) - 3)
+		conforming[i] = math.Pow(10, exp+src.Float64())
+	}
+	if df := b.DistortionFactor(conforming); math.Abs(df) > 1.0 {
+		t.Errorf("expected a distortion factor near zero for genuinely Benford-conforming data, got %v", df)
+	}
+
+	// round-number invoices: every value is an exact multiple of 100
+	// (100, 200, ..., 900 in Benford-correct proportions), the classic
+	// "padded round numbers" pattern the distortion factor is built to
+	// catch. Every leading figure has zero fractional part, so the
+	// actual mean sits a full 0.5 below the expected per-bucket midpoint.
+	roundNumbers := make([]float64, samples)
+	for i := range roundNumbers {
+		digit := float64(b.Rand())
+		roundNumbers[i] = digit * 100
+	}
+	if df := b.DistortionFactor(roundNumbers); df >= -10 {
+		t.Errorf("expected a clearly negative distortion factor for round-number data, got %v", df)
+	}
+}
+
+// eof
@@ -0,0 +1,116 @@
+package benfords_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/DataFinnovation/go-benfords/benfords"
+	"golang.org/x/exp/rand"
+)
+
+func TestKuiperStat(t *testing.T) {
+	const samples = 100000
+	b := benfords.Benfords{Base: 10}
+	pdf := b.FullPDF()
+
+	realised := make([]float64, len(pdf))
+	for i := 0; i < samples; i++ {
+		d := b.Rand()
+		realised[d-1] += 1.0 / float64(samples)
+	}
+
+	kuiper := b.KuiperStat(samples, realised)
+	// Use the 0.001 critical value, not 0.05, for this single-draw smoke
+	// test: at a correctly calibrated 5% significance level a lone draw
+	// is *expected* to exceed the 0.05 critical value one time in twenty,
+	// which would make the test flaky. TestKuiperStatCalibration is the
+	// test that actually checks the false-positive rate at alpha=0.05.
+	critical, ok := benfords.KuiperCriticalValueBase10(0.001)
+	if !ok {
+		t.Fatal("expected a known critical value for alpha=0.001")
+	}
+	if kuiper > critical {
+		t.Errorf("Kuiper stat looks invalid for Benford-distributed data: %v > %v", kuiper, critical)
+	}
+}
+
+// TestKuiperStatCalibration checks that KuiperCriticalValueBase10 actually
+// rejects genuinely Benford-distributed data at roughly the stated
+// significance level, rather than being drastically over- or
+// under-conservative. A single draw staying under the critical value (as
+// in TestKuiperStat) would not catch a miscalibrated table; this repeats
+// the draw many times and checks the empirical false-positive rate.
+func TestKuiperStatCalibration(t *testing.T) {
+	const trials = 1000
+	const n = 20000
+	const alpha = 0.05
+
+	b := benfords.Benfords{Base: 10}
+	critical, ok := benfords.KuiperCriticalValueBase10(alpha)
+	if !ok {
+		t.Fatal("expected a known critical value for alpha=0.05")
+	}
+
+	rejections := 0
+	for trial := 0; trial < trials; trial++ {
+		dist := make([]float64, b.Base-1)
+		for i := 0; i < n; i++ {
+			dist[b.Rand()-1]++
+		}
+		for i := range dist {
+			dist[i] /= float64(n)
+		}
+		if b.KuiperStat(n, dist) > critical {
+			rejections++
+		}
+	}
+
+	rate := float64(rejections) / float64(trials)
+	if rate < alpha/3 || rate > alpha*3 {
+		t.Errorf("empirical false-positive rate %v is far from nominal alpha %v (critical=%v)", rate, alpha, critical)
+	}
+}
+
+func TestMantissaArcTest(t *testing.T) {
+	const samples = 100000
+
+	values := make([]float64, samples)
+	src := rand.New(rand.NewSource(1))
+	for i := range values {
+		// log10(value) mod 1 is exactly uniform by construction, which is
+		// what Benford's law requires of the mantissa.
+		exp := float64(src.Intn(6) - 3)
+		values[i] = math.Pow(10, exp+src.Float64())
+	}
+
+	L2, pValue := benfords.MantissaArcTest(values)
+	critical, ok := benfords.MantissaArcCriticalValueBase10(0.05)
+	if !ok {
+		t.Fatal("expected a known critical value for alpha=0.05")
+	}
+	if 2*float64(samples)*L2 > critical {
+		t.Errorf("Mantissa Arc Test statistic looks invalid: 2*n*L2=%v > %v", 2*float64(samples)*L2, critical)
+	}
+	if pValue < 0.05 {
+		t.Errorf("Mantissa Arc Test p-value too low for Benford-distributed data: %v", pValue)
+	}
+}
+
+func TestMantissaArcTestNonConforming(t *testing.T) {
+	const samples = 500000
+
+	// a textbook non-Benford dataset: every mantissa crammed into a
+	// 0.001-wide sliver instead of spread uniformly over [0,1).
+	values := make([]float64, samples)
+	src := rand.New(rand.NewSource(1))
+	for i := range values {
+		values[i] = math.Pow(10, 0.001*src.Float64())
+	}
+
+	L2, pValue := benfords.MantissaArcTest(values)
+	if pValue > 0.01 {
+		t.Errorf("expected a small Mantissa Arc Test p-value for non-conforming data, got %v (L2=%v)", pValue, L2)
+	}
+}
+
+// eof
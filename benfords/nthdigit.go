@@ -0,0 +1,108 @@
+package benfords
+
+import "math"
+
+// ProbNth returns the probability that the digit at the given
+// significant-digit position equals digit, under the generalized
+// Benford's law. position 1 is the leading digit and is identical
+// to Prob. For position >= 2 the valid range of digit is 0..Base-1
+// (leading zeros are allowed at later positions).
+func (b Benfords) ProbNth(digit, position int) float64 {
+	if position == 1 {
+		return b.Prob(digit)
+	}
+	if position < 1 {
+		return 0
+	}
+	if digit < 0 || digit > b.Base-1 {
+		return 0
+	}
+	lower := int(math.Pow(float64(b.Base), float64(position-2)))
+	upper := int(math.Pow(float64(b.Base), float64(position-1)))
+	tot := 0.0
+	for k := lower; k < upper; k++ {
+		tot += math.Log(1.0+1.0/float64(b.Base*k+digit)) / math.Log(float64(b.Base))
+	}
+	return tot
+}
+
+// FullPDFNth returns the full discrete PDF for the given significant-digit
+// position. For position 1 this is identical to FullPDF. For position >= 2
+// the result has Base entries, one for each digit 0..Base-1.
+func (b Benfords) FullPDFNth(position int) []float64 {
+	if position <= 1 {
+		return b.FullPDF()
+	}
+	res := make([]float64, b.Base)
+	for d := range res {
+		res[d] = b.ProbNth(d, position)
+	}
+	return res
+}
+
+// LeadDigits returns the first `positions` significant digits of n in the
+// given base, most significant digit first. It generalizes LeadDigit to
+// more than one position.
+func LeadDigits(n float64, base, positions int) []int {
+	if positions < 1 {
+		return nil
+	}
+	digits := make([]int, positions)
+	if n < 0 {
+		n = -n
+	}
+	if n == 0 {
+		return digits
+	}
+	lower := math.Pow(float64(base), float64(positions-1))
+	upper := math.Pow(float64(base), float64(positions))
+	for n < lower {
+		n *= float64(base)
+	}
+	for n >= upper {
+		n /= float64(base)
+	}
+	resid := int(n)
+	for i := positions - 1; i >= 0; i-- {
+		digits[i] = resid % base
+		resid /= base
+	}
+	return digits
+}
+
+// ComputeNthDigitDistribution takes a vector of values and computes the
+// distribution of the digit found at the given significant-digit position,
+// in the given base. It mirrors ComputeLeadDigitDistribution, pulling out
+// 0s and NaNs, and returns both the distribution and the number of useful
+// data points that were found.
+func ComputeNthDigitDistribution(values []float64, base, position int) ([]float64, int) {
+	validValues := 0.0
+	var dist []float64
+	if position == 1 {
+		dist = make([]float64, base-1)
+	} else {
+		dist = make([]float64, base)
+	}
+	for _, v := range values {
+		if v == 0.0 || math.IsNaN(v) {
+			continue
+		}
+		digits := LeadDigits(v, base, position)
+		if len(digits) < position {
+			continue
+		}
+		validValues++
+		d := digits[position-1]
+		if position == 1 {
+			dist[d-1]++
+		} else {
+			dist[d]++
+		}
+	}
+	for i, v := range dist {
+		dist[i] = v / validValues
+	}
+	return dist, int(validValues)
+}
+
+// eof
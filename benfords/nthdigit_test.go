@@ -0,0 +1,64 @@
+package benfords_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/DataFinnovation/go-benfords/benfords"
+)
+
+func TestFullPDFNthConverges(t *testing.T) {
+	// Later significant digits approach a uniform distribution, see
+	// https://en.wikipedia.org/wiki/Benford%27s_law#Generalization_to_digits_beyond_the_first
+	for _, base := range []int{10, 16} {
+		b := benfords.Benfords{Base: base}
+		for position := 2; position <= 4; position++ {
+			pdf := b.FullPDFNth(position)
+			if len(pdf) != base {
+				t.Fatalf("unexpected pdf length for base %d position %d: got %d", base, position, len(pdf))
+			}
+			tot := 0.0
+			for _, p := range pdf {
+				tot += p
+			}
+			if math.Abs(tot-1.0) > 1e-9 {
+				t.Errorf("pdf for base %d position %d does not sum to 1: %v", base, position, tot)
+			}
+			uniform := 1.0 / float64(base)
+			maxDiff := 0.0
+			for _, p := range pdf {
+				maxDiff = math.Max(maxDiff, math.Abs(p-uniform))
+			}
+			if position == 4 && maxDiff > 0.01 {
+				t.Errorf("base %d position %d pdf not close enough to uniform: maxDiff %v", base, position, maxDiff)
+			}
+		}
+	}
+}
+
+func TestLeadDigits(t *testing.T) {
+	for _, test := range []struct {
+		n        float64
+		base     int
+		position int
+		want     []int
+	}{
+		{n: 314159, base: 10, position: 1, want: []int{3}},
+		{n: 314159, base: 10, position: 2, want: []int{3, 1}},
+		{n: 314159, base: 10, position: 3, want: []int{3, 1, 4}},
+		{n: 0.00271828, base: 10, position: 2, want: []int{2, 7}},
+		{n: 255, base: 16, position: 2, want: []int{15, 15}},
+	} {
+		got := benfords.LeadDigits(test.n, test.base, test.position)
+		if len(got) != len(test.want) {
+			t.Fatalf("LeadDigits(%v, %d, %d) = %v, want %v", test.n, test.base, test.position, got, test.want)
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("LeadDigits(%v, %d, %d) = %v, want %v", test.n, test.base, test.position, got, test.want)
+			}
+		}
+	}
+}
+
+// eof
@@ -0,0 +1,59 @@
+package benfords
+
+import (
+	"sort"
+
+	"golang.org/x/exp/rand"
+)
+
+// Sampler wraps a Benfords distribution with a precomputed CDF, so that
+// repeated sampling does not rebuild FullCDF and scan it linearly on every
+// call. Use NewSampler once per distribution and reuse it across samples.
+type Sampler struct {
+	B      Benfords
+	cdf    []float64
+	domain []int
+	rnd    *rand.Rand
+}
+
+// NewSampler precomputes the CDF and domain for b and returns a Sampler
+// ready for repeated use. If b.Src is set, the *rand.Rand built from it is
+// constructed once here and reused across samples.
+func NewSampler(b Benfords) *Sampler {
+	s := &Sampler{
+		B:      b,
+		cdf:    b.FullCDF(),
+		domain: b.Domain(),
+	}
+	if b.Src != nil {
+		s.rnd = rand.New(b.Src)
+	}
+	return s
+}
+
+// Rand returns a random sample from the distribution, using binary search
+// over the cached CDF instead of a linear scan.
+func (s *Sampler) Rand() int {
+	var p float64
+	if s.rnd != nil {
+		p = s.rnd.Float64()
+	} else {
+		p = rand.Float64()
+	}
+	i := sort.Search(len(s.cdf), func(i int) bool { return p < s.cdf[i] })
+	if i == len(s.domain) {
+		return s.B.Base - 1
+	}
+	return s.domain[i]
+}
+
+// RandBatch returns n random samples from the distribution.
+func (s *Sampler) RandBatch(n int) []int {
+	res := make([]int, n)
+	for i := range res {
+		res[i] = s.Rand()
+	}
+	return res
+}
+
+// eof
@@ -0,0 +1,43 @@
+package benfords_test
+
+import (
+	"testing"
+
+	"github.com/DataFinnovation/go-benfords/benfords"
+	"golang.org/x/exp/rand"
+	"gonum.org/v1/gonum/stat"
+)
+
+func TestSampler(t *testing.T) {
+	const samples = 1000000
+	b := benfords.Benfords{Base: 10}
+	s := benfords.NewSampler(b)
+
+	realised := make([]float64, b.Base-1)
+	for _, d := range s.RandBatch(samples) {
+		realised[d-1] += 1.0 / float64(samples)
+	}
+
+	pstat := stat.ChiSquare(realised, b.FullPDF())
+	if pstat > 0.01 {
+		t.Error("Chi Squared stat looks invalid for cached sampler: ", pstat)
+	}
+}
+
+func TestSamplerWithSrc(t *testing.T) {
+	const samples = 1000000
+	b := benfords.Benfords{Base: 10, Src: rand.NewSource(1)}
+	s := benfords.NewSampler(b)
+
+	realised := make([]float64, b.Base-1)
+	for _, d := range s.RandBatch(samples) {
+		realised[d-1] += 1.0 / float64(samples)
+	}
+
+	pstat := stat.ChiSquare(realised, b.FullPDF())
+	if pstat > 0.01 {
+		t.Error("Chi Squared stat looks invalid for cached sampler with a Src: ", pstat)
+	}
+}
+
+// eof
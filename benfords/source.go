@@ -0,0 +1,262 @@
+package benfords
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Source yields a stream of float64 values for Benford analysis. It
+// decouples the distribution-computing functions in this package from any
+// particular file format. When Next returns ok == false and err == nil,
+// the source is exhausted.
+type Source interface {
+	Next() (value float64, ok bool, err error)
+}
+
+// RecordSource yields a stream of rows, each holding the numeric values
+// found in one record, for per-column Benford analysis. The returned slice
+// is keyed by the field's original position in the record: values[i] is
+// math.NaN() if field i was absent or did not parse as a number, so that a
+// short or sparse row never shifts later columns out of place.
+type RecordSource interface {
+	NextRecord() (values []float64, ok bool, err error)
+}
+
+// FilterOptions controls which values a Source (wrapped with Filter)
+// passes through. Benford's law is scale-invariant but requires the data
+// to span multiple orders of magnitude, so callers commonly want to drop
+// negatives and clamp to an absolute-value range.
+type FilterOptions struct {
+	// SkipNegatives drops values less than zero.
+	SkipNegatives bool
+	// MinAbs drops values whose absolute value is below it. Zero means
+	// no lower bound.
+	MinAbs float64
+	// MaxAbs drops values whose absolute value is above it. Zero means
+	// no upper bound.
+	MaxAbs float64
+}
+
+// Filter wraps src so that only values satisfying opts are returned.
+func Filter(src Source, opts FilterOptions) Source {
+	return &filteredSource{src: src, opts: opts}
+}
+
+type filteredSource struct {
+	src  Source
+	opts FilterOptions
+}
+
+func (f *filteredSource) Next() (float64, bool, error) {
+	for {
+		v, ok, err := f.src.Next()
+		if !ok || err != nil {
+			return v, ok, err
+		}
+		if f.opts.SkipNegatives && v < 0 {
+			continue
+		}
+		abs := math.Abs(v)
+		if f.opts.MinAbs > 0 && abs < f.opts.MinAbs {
+			continue
+		}
+		if f.opts.MaxAbs > 0 && abs > f.opts.MaxAbs {
+			continue
+		}
+		return v, true, nil
+	}
+}
+
+// CSVSource reads one column of numeric values out of a CSV file.
+// Rows whose value in that column does not parse as a float are skipped.
+type CSVSource struct {
+	r      *csv.Reader
+	column int
+}
+
+// NewCSVSource returns a Source reading the given 0-indexed column from r.
+func NewCSVSource(r io.Reader, column int) *CSVSource {
+	return &CSVSource{r: csv.NewReader(r), column: column}
+}
+
+// Next implements Source.
+func (s *CSVSource) Next() (float64, bool, error) {
+	for {
+		record, err := s.r.Read()
+		if err == io.EOF {
+			return 0, false, nil
+		}
+		if err != nil {
+			return 0, false, err
+		}
+		if s.column >= len(record) {
+			continue
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(record[s.column]), 64)
+		if err != nil {
+			continue
+		}
+		return v, true, nil
+	}
+}
+
+// CSVRecordSource reads every numeric field out of each CSV row, for
+// per-column Benford analysis. Fields are reported at their original CSV
+// column index; a field that is blank or does not parse as a number is
+// reported as math.NaN() rather than being dropped, so a short or sparse
+// row never shifts later columns into the wrong place.
+type CSVRecordSource struct {
+	r *csv.Reader
+}
+
+// NewCSVRecordSource returns a RecordSource over every field of r.
+func NewCSVRecordSource(r io.Reader) *CSVRecordSource {
+	return &CSVRecordSource{r: csv.NewReader(r)}
+}
+
+// NextRecord implements RecordSource.
+func (s *CSVRecordSource) NextRecord() ([]float64, bool, error) {
+	record, err := s.r.Read()
+	if err == io.EOF {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	values := make([]float64, len(record))
+	for i, field := range record {
+		v, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+		if err != nil {
+			v = math.NaN()
+		}
+		values[i] = v
+	}
+	return values, true, nil
+}
+
+// JSONArraySource reads values out of a top-level JSON array of numbers,
+// e.g. [1, 2.5, -300]. It streams the array via a json.Decoder rather than
+// unmarshalling it all at once.
+type JSONArraySource struct {
+	dec     *json.Decoder
+	started bool
+}
+
+// NewJSONArraySource returns a Source reading the JSON array in r.
+func NewJSONArraySource(r io.Reader) *JSONArraySource {
+	return &JSONArraySource{dec: json.NewDecoder(r)}
+}
+
+// Next implements Source.
+func (s *JSONArraySource) Next() (float64, bool, error) {
+	if !s.started {
+		tok, err := s.dec.Token()
+		if err != nil {
+			return 0, false, err
+		}
+		delim, ok := tok.(json.Delim)
+		if !ok || delim != '[' {
+			return 0, false, fmt.Errorf("benfords: expected JSON array, got %v", tok)
+		}
+		s.started = true
+	}
+	if !s.dec.More() {
+		if _, err := s.dec.Token(); err != nil {
+			return 0, false, err
+		}
+		return 0, false, nil
+	}
+	var v float64
+	if err := s.dec.Decode(&v); err != nil {
+		return 0, false, err
+	}
+	return v, true, nil
+}
+
+// ComputeLeadDigitDistributionFromSource drains src and computes the
+// first-digit distribution of the values it yields, in the given base.
+// It mirrors ComputeLeadDigitDistribution, pulling out 0s and NaNs.
+func ComputeLeadDigitDistributionFromSource(src Source, base int) ([]float64, int, error) {
+	var values []float64
+	for {
+		v, ok, err := src.Next()
+		if err != nil {
+			return nil, 0, err
+		}
+		if !ok {
+			break
+		}
+		values = append(values, v)
+	}
+	dist, n := ComputeLeadDigitDistribution(values, base)
+	return dist, n, nil
+}
+
+// ComputeLeadDigitDistributionsPerColumn drains src and computes one
+// first-digit distribution per column of numeric values found in its
+// records, in the given base. Columns are keyed by original field index,
+// as reported by RecordSource; NaN entries (blank or non-numeric fields)
+// are pulled out by ComputeLeadDigitDistribution like any other NaN.
+func ComputeLeadDigitDistributionsPerColumn(src RecordSource, base int) ([][]float64, []int, error) {
+	var columns [][]float64
+	for {
+		rec, ok, err := src.NextRecord()
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			break
+		}
+		for len(columns) < len(rec) {
+			columns = append(columns, nil)
+		}
+		for i, v := range rec {
+			columns[i] = append(columns[i], v)
+		}
+	}
+	dists := make([][]float64, len(columns))
+	counts := make([]int, len(columns))
+	for i, col := range columns {
+		dists[i], counts[i] = ComputeLeadDigitDistribution(col, base)
+	}
+	return dists, counts, nil
+}
+
+// stringSliceSource adapts a []string, as parsed by strconv.ParseFloat, to
+// the Source interface.
+type stringSliceSource struct {
+	values []string
+	i      int
+}
+
+func (s *stringSliceSource) Next() (float64, bool, error) {
+	for s.i < len(s.values) {
+		str := s.values[s.i]
+		s.i++
+		v, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			continue
+		}
+		return v, true, nil
+	}
+	return 0, false, nil
+}
+
+// ComputeLeadDigitDistributionFromStrings does what it says, relying on
+// the strconv.ParseFloat function. It is a thin wrapper over
+// ComputeLeadDigitDistributionFromSource.
+func ComputeLeadDigitDistributionFromStrings(strs []string, base int) ([]float64, int) {
+	dist, n, err := ComputeLeadDigitDistributionFromSource(&stringSliceSource{values: strs}, base)
+	if err != nil {
+		log.Panic(err)
+	}
+	return dist, n
+}
+
+// eof
@@ -0,0 +1,82 @@
+//go:build parquet
+
+package benfords
+
+import (
+	"fmt"
+
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+// ParquetSource reads one numeric column out of a Parquet file. It is
+// built only when compiling with the "parquet" build tag, so that the
+// default build of this package does not pull in a Parquet dependency.
+type ParquetSource struct {
+	pr     *reader.ParquetReader
+	column string
+	row    int64
+	rows   int64
+}
+
+// NewParquetSource opens path and returns a Source over the named column.
+func NewParquetSource(path, column string) (*ParquetSource, error) {
+	fr, err := local.NewLocalFileReader(path)
+	if err != nil {
+		return nil, err
+	}
+	pr, err := reader.NewParquetColumnReader(fr, 4)
+	if err != nil {
+		return nil, err
+	}
+	return &ParquetSource{
+		pr:     pr,
+		column: column,
+		rows:   pr.GetNumRows(),
+	}, nil
+}
+
+// Close releases the underlying Parquet reader.
+func (s *ParquetSource) Close() error {
+	s.pr.ReadStop()
+	return nil
+}
+
+// Next implements Source.
+func (s *ParquetSource) Next() (float64, bool, error) {
+	for s.row < s.rows {
+		values, _, _, err := s.pr.ReadColumnByPath(s.column, 1)
+		s.row++
+		if err != nil {
+			return 0, false, err
+		}
+		if len(values) == 0 {
+			continue
+		}
+		v, err := toFloat64(values[0])
+		if err != nil {
+			return 0, false, err
+		}
+		return v, true, nil
+	}
+	return 0, false, nil
+}
+
+// toFloat64 converts the numeric scalar types produced by the Parquet
+// reader into a float64.
+func toFloat64(v interface{}) (float64, error) {
+	switch n := v.(type) {
+	case float64:
+		return n, nil
+	case float32:
+		return float64(n), nil
+	case int32:
+		return float64(n), nil
+	case int64:
+		return float64(n), nil
+	default:
+		return 0, fmt.Errorf("benfords: unsupported Parquet value type %T", v)
+	}
+}
+
+// eof
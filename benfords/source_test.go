@@ -0,0 +1,125 @@
+package benfords_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/DataFinnovation/go-benfords/benfords"
+)
+
+func TestCSVSource(t *testing.T) {
+	csvData := "name,amount\na,123\nb,-456\nc,not-a-number\nd,789\n"
+	src := benfords.NewCSVSource(strings.NewReader(csvData), 1)
+
+	dist, n, err := benfords.ComputeLeadDigitDistributionFromSource(src, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 3 {
+		t.Fatalf("expected 3 valid values, got %d", n)
+	}
+	// leading digits: 123 -> 1, -456 -> 4, 789 -> 7
+	for _, d := range []int{1, 4, 7} {
+		if dist[d-1] != 1.0/3.0 {
+			t.Errorf("expected digit %d to have frequency 1/3, got %v", d, dist[d-1])
+		}
+	}
+}
+
+func TestCSVSourceWithFilter(t *testing.T) {
+	csvData := "1\n-2\n300\n4000\n"
+	filtered := benfords.Filter(benfords.NewCSVSource(strings.NewReader(csvData), 0), benfords.FilterOptions{
+		SkipNegatives: true,
+		MinAbs:        10,
+	})
+
+	dist, n, err := benfords.ComputeLeadDigitDistributionFromSource(filtered, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 2 { // "-2" dropped for being negative, "1" dropped for being below MinAbs
+		t.Fatalf("expected 2 valid values after filtering, got %d", n)
+	}
+	// leading digits: 300 -> 3, 4000 -> 4
+	if dist[2] != 0.5 || dist[3] != 0.5 {
+		t.Errorf("unexpected distribution after filtering: %v", dist)
+	}
+}
+
+func TestJSONArraySource(t *testing.T) {
+	src := benfords.NewJSONArraySource(strings.NewReader(`[123, 456, 789]`))
+	dist, n, err := benfords.ComputeLeadDigitDistributionFromSource(src, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 3 {
+		t.Fatalf("expected 3 valid values, got %d", n)
+	}
+	for _, d := range []int{1, 4, 7} {
+		if dist[d-1] != 1.0/3.0 {
+			t.Errorf("expected digit %d to have frequency 1/3, got %v", d, dist[d-1])
+		}
+	}
+}
+
+func TestComputeLeadDigitDistributionsPerColumn(t *testing.T) {
+	csvData := "1,100\n2,200\n3,300\n"
+	src := benfords.NewCSVRecordSource(strings.NewReader(csvData))
+
+	dists, counts, err := benfords.ComputeLeadDigitDistributionsPerColumn(src, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(dists) != 2 {
+		t.Fatalf("expected 2 columns, got %d", len(dists))
+	}
+	if counts[0] != 3 || counts[1] != 3 {
+		t.Fatalf("expected 3 values per column, got %v", counts)
+	}
+	// leading digits: 100 -> 1, 200 -> 2, 300 -> 3
+	for _, d := range []int{1, 2, 3} {
+		if dists[1][d-1] != 1.0/3.0 {
+			t.Errorf("expected digit %d in second column to have frequency 1/3, got %v", d, dists[1])
+		}
+	}
+}
+
+func TestComputeLeadDigitDistributionsPerColumnSparseRow(t *testing.T) {
+	// the middle row's blank "amount" field must not shift the "date"
+	// column's 2021 into the "amount" column.
+	csvData := "1,100,2020\n2,,2021\n3,300,2022\n"
+	src := benfords.NewCSVRecordSource(strings.NewReader(csvData))
+
+	dists, counts, err := benfords.ComputeLeadDigitDistributionsPerColumn(src, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(dists) != 3 {
+		t.Fatalf("expected 3 columns, got %d", len(dists))
+	}
+	if counts[0] != 3 || counts[1] != 2 || counts[2] != 3 {
+		t.Fatalf("expected counts [3 2 3], got %v", counts)
+	}
+	// amount column (index 1) only has 100 and 300, both leading digit 1 and 3
+	if dists[1][0] != 0.5 || dists[1][2] != 0.5 {
+		t.Errorf("expected amount column distribution to come from [100, 300], got %v", dists[1])
+	}
+	// date column (index 2) must not have picked up the blank amount field
+	for _, d := range []int{2} {
+		if dists[2][d-1] != 1.0 {
+			t.Errorf("expected date column to be entirely leading digit 2, got %v", dists[2])
+		}
+	}
+}
+
+func TestComputeLeadDigitDistributionFromStrings(t *testing.T) {
+	dist, n := benfords.ComputeLeadDigitDistributionFromStrings([]string{"123", "not-a-number", "456"}, 10)
+	if n != 2 {
+		t.Fatalf("expected 2 valid values, got %d", n)
+	}
+	if dist[0] != 0.5 || dist[3] != 0.5 {
+		t.Errorf("unexpected distribution: %v", dist)
+	}
+}
+
+// eof
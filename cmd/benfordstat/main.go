@@ -0,0 +1,76 @@
+// Command benfordstat compares the first-digit distributions of two sets
+// of numeric samples, one number per line, in the style of benchstat
+// comparing two benchmark runs.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/DataFinnovation/go-benfords/benfords"
+)
+
+func main() {
+	base := flag.Int("base", 10, "base in which to consider digits")
+	html := flag.Bool("html", false, "emit an HTML report instead of plain text")
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		fmt.Fprintln(os.Stderr, "usage: benfordstat [flags] old.txt new.txt")
+		os.Exit(1)
+	}
+
+	a, err := readNumbers(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	b, err := readNumbers(flag.Arg(1))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(a) == 0 || len(b) == 0 {
+		log.Fatalf("need at least one numeric value in each of %s and %s", flag.Arg(0), flag.Arg(1))
+	}
+
+	report := benfords.CompareDistributions(a, b, *base)
+
+	if *html {
+		if err := report.WriteHTML(os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if err := report.WriteText(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readNumbers reads one float64 per line from path, skipping lines that do
+// not parse as numbers.
+func readNumbers(path string) ([]float64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var values []float64
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		v, err := strconv.ParseFloat(scanner.Text(), 64)
+		if err != nil {
+			continue
+		}
+		values = append(values, v)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// eof